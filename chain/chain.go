@@ -36,7 +36,7 @@ func LoadMainChain(ctx *cli.Context, chainId string) *Chain {
 
 	//always start ethereum
 	log.Info("ethereum.MakeSystemNode")
-	stack := ethereum.MakeSystemNode(chainId, version.Version, ctx, GetCMInstance(ctx).cch, mining)
+	stack := ethereum.MakeSystemNode(chainId, version.Version, ctx, GetCMInstance(ctx).cch, mining, true)
 	chain.EthNode = stack
 
 	rpcHandler, err := stack.GetRPCHandler()
@@ -67,7 +67,7 @@ func LoadChildChain(ctx *cli.Context, chainId string, mining bool) *Chain {
 	//always start ethereum
 	log.Infof("chainId: %s, ethereum.MakeSystemNode", chainId)
 	cch := GetCMInstance(ctx).cch
-	stack := ethereum.MakeSystemNode(chainId, version.Version, ctx, cch, mining)
+	stack := ethereum.MakeSystemNode(chainId, version.Version, ctx, cch, mining, false)
 	chain.EthNode = stack
 
 	rpcHandler, err := stack.GetRPCHandler()